@@ -2,13 +2,20 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"log"
 	"log/slog"
 	"math/rand"
 	"net"
 	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	"go.opentelemetry.io/contrib/bridges/otelslog"
@@ -16,8 +23,14 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/log/global"
 	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/propagation"
@@ -28,13 +41,17 @@ import (
 	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
 
 	pb "service-a/proto"
 )
 
 var (
-	tracer          trace.Tracer
+	tracers         *TracerRouter
 	logger          *slog.Logger
 	meter           metric.Meter
 	requestCounter  metric.Int64Counter
@@ -54,19 +71,139 @@ func logWithContext(ctx context.Context, level, msg string, args ...interface{})
 	}
 }
 
+// reexecListenerFDEnv marks a re-exec'd child and the fd its listener was handed on.
+const reexecListenerFDEnv = "SERVICE_A_LISTENER_FD"
+
+// shutdownFlushTimeout bounds how long shutdown waits for telemetry to flush.
+const shutdownFlushTimeout = 10 * time.Second
+
+type clientOperationKey struct{}
+
+// clientOperationState tracks one logical downstream call across its attempts.
+type clientOperationState struct {
+	start         time.Time
+	targetService string
+	method        string
+	attempts      int64
+	firstByteOnce sync.Once
+}
+
+// withClientOperation starts a new logical-call boundary on ctx.
+func withClientOperation(ctx context.Context, targetService, method string) (context.Context, *clientOperationState) {
+	op := &clientOperationState{start: time.Now(), targetService: targetService, method: method}
+	return context.WithValue(ctx, clientOperationKey{}, op), op
+}
+
+type clientMetrics struct {
+	attemptLatency       metric.Float64Histogram
+	operationLatency     metric.Float64Histogram
+	attemptCount         metric.Int64Histogram
+	firstResponseLatency metric.Float64Histogram
+}
+
+func newClientMetrics(meter metric.Meter) (*clientMetrics, error) {
+	attemptLatency, err := meter.Float64Histogram("service_a_client_attempt_latency",
+		metric.WithDescription("Latency of a single gRPC attempt to a downstream service"),
+		metric.WithUnit("ms"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attempt latency histogram: %w", err)
+	}
+
+	operationLatency, err := meter.Float64Histogram("service_a_client_operation_latency",
+		metric.WithDescription("Wall-clock latency of a logical downstream call across all attempts"),
+		metric.WithUnit("ms"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create operation latency histogram: %w", err)
+	}
+
+	attemptCount, err := meter.Int64Histogram("service_a_client_attempt_count",
+		metric.WithDescription("Number of gRPC attempts made per logical downstream call"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attempt count histogram: %w", err)
+	}
+
+	firstResponseLatency, err := meter.Float64Histogram("service_a_client_first_response_latency",
+		metric.WithDescription("Time to the first response payload of a logical downstream call"),
+		metric.WithUnit("ms"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create first response latency histogram: %w", err)
+	}
+
+	return &clientMetrics{
+		attemptLatency:       attemptLatency,
+		operationLatency:     operationLatency,
+		attemptCount:         attemptCount,
+		firstResponseLatency: firstResponseLatency,
+	}, nil
+}
+
+// clientStatsHandler composes otelgrpc's client handler with Service A's custom client metrics.
+type clientStatsHandler struct {
+	stats.Handler
+	metrics *clientMetrics
+}
+
+func newClientStatsHandler(metrics *clientMetrics) stats.Handler {
+	return &clientStatsHandler{
+		Handler: otelgrpc.NewClientHandler(),
+		metrics: metrics,
+	}
+}
+
+func (h *clientStatsHandler) HandleRPC(ctx context.Context, rs stats.RPCStats) {
+	h.Handler.HandleRPC(ctx, rs)
+
+	op, _ := ctx.Value(clientOperationKey{}).(*clientOperationState)
+	if op == nil {
+		return
+	}
+
+	switch s := rs.(type) {
+	case *stats.InPayload:
+		op.firstByteOnce.Do(func() {
+			h.metrics.firstResponseLatency.Record(ctx, float64(s.RecvTime.Sub(op.start).Milliseconds()),
+				metric.WithAttributes(
+					attribute.String("target_service", op.targetService),
+					attribute.String("method", op.method),
+				))
+		})
+
+	case *stats.End:
+		atomic.AddInt64(&op.attempts, 1)
+		h.metrics.attemptLatency.Record(ctx, float64(s.EndTime.Sub(s.BeginTime).Milliseconds()),
+			metric.WithAttributes(
+				attribute.String("grpc.status", status.Code(s.Error).String()),
+				attribute.String("target_service", op.targetService),
+				attribute.String("method", op.method),
+				attribute.Bool("streaming", false),
+			))
+	}
+}
+
 type server struct {
 	pb.UnimplementedServiceAServer
 	serviceBConn   *grpc.ClientConn
 	serviceBClient pb.ServiceBClient
 	serviceCConn   *grpc.ClientConn
 	serviceCClient pb.ServiceCClient
+
+	clientMetrics *clientMetrics
+
+	// activeIterations tracks in-flight workload iterations for shutdown draining.
+	activeIterations int64
 }
 
-func newServer(serviceBAddr, serviceCAddr string) (*server, error) {
+func newServer(serviceBAddr, serviceCAddr string, clientMeter metric.Meter) (*server, error) {
+	clientMetrics, err := newClientMetrics(clientMeter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client metrics: %w", err)
+	}
+	statsHandler := newClientStatsHandler(clientMetrics)
+
 	// Connect to Service B
 	serviceBConn, err := grpc.Dial(serviceBAddr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithStatsHandler(statsHandler),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to Service B: %v", err)
@@ -75,7 +212,7 @@ func newServer(serviceBAddr, serviceCAddr string) (*server, error) {
 	// Connect to Service C
 	serviceCConn, err := grpc.Dial(serviceCAddr,
 		grpc.WithTransportCredentials(insecure.NewCredentials()),
-		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithStatsHandler(statsHandler),
 	)
 	if err != nil {
 		serviceBConn.Close()
@@ -87,19 +224,157 @@ func newServer(serviceBAddr, serviceCAddr string) (*server, error) {
 		serviceBClient: pb.NewServiceBClient(serviceBConn),
 		serviceCConn:   serviceCConn,
 		serviceCClient: pb.NewServiceCClient(serviceCConn),
+		clientMetrics:  clientMetrics,
 	}, nil
 }
 
+// CallPolicy controls how callDownstream retries and hedges a downstream call.
+type CallPolicy struct {
+	// PerAttemptTimeout bounds a single gRPC attempt; zero leaves the caller's context deadline untouched.
+	PerAttemptTimeout time.Duration
+
+	// MaxAttempts is the most attempts (including the first); values below 1 are treated as 1.
+	MaxAttempts int
+
+	// Each retry waits BackoffBase*2^(attempt-1), capped at BackoffMax, plus up to 20% jitter.
+	BackoffBase time.Duration
+	BackoffMax  time.Duration
+
+	RetryableCodes []codes.Code
+
+	// HedgeDelay, if non-zero, races a second attempt after this delay. Zero disables hedging.
+	HedgeDelay time.Duration
+}
+
+func defaultCallPolicy() CallPolicy {
+	return CallPolicy{
+		PerAttemptTimeout: 2 * time.Second,
+		MaxAttempts:       3,
+		BackoffBase:       50 * time.Millisecond,
+		BackoffMax:        1 * time.Second,
+		RetryableCodes:    []codes.Code{codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted},
+	}
+}
+
+func isRetryableError(err error, retryableCodes []codes.Code) bool {
+	if err == nil {
+		return false
+	}
+	errCode := status.Code(err)
+	for _, c := range retryableCodes {
+		if c == errCode {
+			return true
+		}
+	}
+	return false
+}
+
+// backoffWithJitter adds up to 20% jitter so retrying clients don't wake up in lockstep.
+func backoffWithJitter(policy CallPolicy, attempt int) time.Duration {
+	backoff := policy.BackoffBase * time.Duration(1<<uint(attempt-1))
+	if policy.BackoffMax > 0 && backoff > policy.BackoffMax {
+		backoff = policy.BackoffMax
+	}
+	jitter := time.Duration(rand.Int63n(int64(backoff)/5 + 1))
+	return backoff + jitter
+}
+
+// runHedgedRequest races a second fn after policy.HedgeDelay if the first hasn't
+// returned yet; the loser keeps running against ctx and its result is discarded.
+func (s *server) runHedgedRequest(ctx context.Context, targetService string, policy CallPolicy, fn func(ctx context.Context) error) error {
+	results := make(chan error, 2)
+	go func() { results <- fn(ctx) }()
+
+	timer := time.NewTimer(policy.HedgeDelay)
+	defer timer.Stop()
+
+	select {
+	case err := <-results:
+		return err
+	case <-timer.C:
+		logWithContext(ctx, "INFO", "Hedging %s request after %s", targetService, policy.HedgeDelay)
+		go func() { results <- fn(ctx) }()
+		return <-results
+	}
+}
+
+// runAttempt runs one retry attempt under its own child span (call-<service>/attempt-<n>).
+func (s *server) runAttempt(ctx context.Context, targetService string, attemptNum int, policy CallPolicy, fn func(ctx context.Context) error) error {
+	spanName := fmt.Sprintf("call-%s/attempt-%d", targetService, attemptNum)
+	attemptCtx, span := tracers.Tracer("service-a", "default").Start(ctx, spanName,
+		trace.WithAttributes(attribute.Int("retry.attempt", attemptNum)))
+	defer span.End()
+
+	if attemptNum > 1 {
+		span.SetAttributes(attribute.String("retry.reason", "previous attempt failed"))
+	}
+
+	if policy.PerAttemptTimeout > 0 {
+		var cancel context.CancelFunc
+		attemptCtx, cancel = context.WithTimeout(attemptCtx, policy.PerAttemptTimeout)
+		defer cancel()
+	}
+
+	var err error
+	if policy.HedgeDelay > 0 {
+		err = s.runHedgedRequest(attemptCtx, targetService, policy, fn)
+	} else {
+		err = fn(attemptCtx)
+	}
+
+	span.SetAttributes(attribute.String("rpc.grpc.status_code", status.Code(err).String()))
+	if err != nil {
+		span.RecordError(err)
+	}
+
+	return err
+}
+
+// callDownstream runs fn as one logical downstream call under policy, retrying
+// per policy.RetryableCodes and recording operation-level client metrics.
+func (s *server) callDownstream(ctx context.Context, targetService, method string, policy CallPolicy, fn func(ctx context.Context) error) error {
+	parentSpan := trace.SpanFromContext(ctx)
+	ctx, op := withClientOperation(ctx, targetService, method)
+	start := time.Now()
+
+	maxAttempts := policy.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	var err error
+	for attemptNum := 1; attemptNum <= maxAttempts; attemptNum++ {
+		err = s.runAttempt(ctx, targetService, attemptNum, policy, fn)
+		if err == nil || attemptNum == maxAttempts || !isRetryableError(err, policy.RetryableCodes) {
+			break
+		}
+		time.Sleep(backoffWithJitter(policy, attemptNum))
+	}
+
+	attrs := metric.WithAttributes(
+		attribute.String("target_service", targetService),
+		attribute.String("method", method),
+	)
+	s.clientMetrics.operationLatency.Record(ctx, float64(time.Since(start).Milliseconds()), attrs)
+	s.clientMetrics.attemptCount.Record(ctx, atomic.LoadInt64(&op.attempts), attrs)
+	parentSpan.SetAttributes(attribute.Int64("retry.attempts", atomic.LoadInt64(&op.attempts)))
+
+	return err
+}
+
 func (s *server) TriggerWorkload(ctx context.Context, req *pb.WorkloadRequest) (*pb.WorkloadResponse, error) {
-	ctx, span := tracer.Start(ctx, "TriggerWorkload",
+	ctx, span := tracers.Tracer("service-a", "public").Start(ctx, "TriggerWorkload",
 		trace.WithAttributes(
 			attribute.String("rpc.system", "grpc"),
 			attribute.String("rpc.service", "ServiceA"),
 			attribute.String("rpc.method", "TriggerWorkload"),
+			attribute.String(spanAudienceKey, "public"),
 		),
 	)
 	defer span.End()
 
+	requestStart := time.Now()
+
 	iterations := int(req.Iterations)
 	if iterations <= 0 {
 		iterations = 50 // Default to 50 iterations
@@ -120,8 +395,11 @@ func (s *server) TriggerWorkload(ctx context.Context, req *pb.WorkloadRequest) (
 	failCount := 0
 
 	for i := 0; i < iterations; i++ {
-		iterCtx, iterSpan := tracer.Start(ctx, fmt.Sprintf("workload-iteration-%d", i+1),
-			trace.WithAttributes(attribute.Int("iteration", i+1)),
+		iterCtx, iterSpan := tracers.Tracer("service-a", "public").Start(ctx, fmt.Sprintf("workload-iteration-%d", i+1),
+			trace.WithAttributes(
+				attribute.Int("iteration", i+1),
+				attribute.String(spanAudienceKey, "public"),
+			),
 		)
 
 		result := s.runIteration(iterCtx, i+1)
@@ -154,10 +432,17 @@ func (s *server) TriggerWorkload(ctx context.Context, req *pb.WorkloadRequest) (
 
 	logWithContext(ctx, "INFO", "Workload complete - success: %d, failed: %d", successCount, failCount)
 
+	requestAttrs := metric.WithAttributes(attribute.String("method", "TriggerWorkload"))
+	requestCounter.Add(ctx, 1, requestAttrs)
+	latencyRecorder.Record(ctx, float64(time.Since(requestStart).Milliseconds()), requestAttrs)
+
 	return response, nil
 }
 
 func (s *server) runIteration(ctx context.Context, iteration int) *pb.IterationResult {
+	atomic.AddInt64(&s.activeIterations, 1)
+	defer atomic.AddInt64(&s.activeIterations, -1)
+
 	start := time.Now()
 	result := &pb.IterationResult{
 		Iteration: int32(iteration),
@@ -176,7 +461,7 @@ func (s *server) runIteration(ctx context.Context, iteration int) *pb.IterationR
 	// Call Service B
 	go func() {
 		defer wg.Done()
-		bCtx, bSpan := tracer.Start(ctx, "call-service-b")
+		bCtx, bSpan := tracers.Tracer("service-a", "default").Start(ctx, "call-service-b")
 		defer bSpan.End()
 
 		logWithContext(bCtx, "INFO", "Iteration %d: Calling Service B...", iteration)
@@ -192,7 +477,10 @@ func (s *server) runIteration(ctx context.Context, iteration int) *pb.IterationR
 			},
 		}
 
-		_, bErr = s.serviceBClient.ProcessData(bCtx, req)
+		bErr = s.callDownstream(bCtx, "service-b", "ProcessData", defaultCallPolicy(), func(ctx context.Context) error {
+			_, err := s.serviceBClient.ProcessData(ctx, req)
+			return err
+		})
 		if bErr != nil {
 			bSpan.RecordError(bErr)
 			logWithContext(bCtx, "ERROR", "Iteration %d: Service B error: %v", iteration, bErr)
@@ -202,7 +490,7 @@ func (s *server) runIteration(ctx context.Context, iteration int) *pb.IterationR
 	// Call Service C
 	go func() {
 		defer wg.Done()
-		cCtx, cSpan := tracer.Start(ctx, "call-service-c")
+		cCtx, cSpan := tracers.Tracer("service-a", "default").Start(ctx, "call-service-c")
 		defer cSpan.End()
 
 		logWithContext(cCtx, "INFO", "Iteration %d: Calling Service C...", iteration)
@@ -219,7 +507,10 @@ func (s *server) runIteration(ctx context.Context, iteration int) *pb.IterationR
 			ModelName: "default-model",
 		}
 
-		_, cErr = s.serviceCClient.RunAnalytics(cCtx, req)
+		cErr = s.callDownstream(cCtx, "service-c", "RunAnalytics", defaultCallPolicy(), func(ctx context.Context) error {
+			_, err := s.serviceCClient.RunAnalytics(ctx, req)
+			return err
+		})
 		if cErr != nil {
 			cSpan.RecordError(cErr)
 			logWithContext(cCtx, "ERROR", "Iteration %d: Service C error: %v", iteration, cErr)
@@ -269,52 +560,315 @@ func newResource(ctx context.Context) (*resource.Resource, error) {
 	)
 }
 
-func initTracer(ctx context.Context, res *resource.Resource) (*sdktrace.TracerProvider, error) {
-	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+// spanAudienceKey records which audience a span belongs to; it's descriptive
+// only, the destination is decided by which audience's Tracer started it.
+const spanAudienceKey = "audience"
+
+// TracerOpts configures one named, independently-exported trace pipeline.
+type TracerOpts struct {
+	Audience string
+	Endpoint string
+}
+
+func defaultTracerOpts() []TracerOpts {
+	privateEndpoint := commonEndpointOrDefault("localhost:4317")
+
+	publicEndpoint := os.Getenv("OTEL_EXPORTER_OTLP_PUBLIC_ENDPOINT")
+	if publicEndpoint == "" {
+		publicEndpoint = privateEndpoint
+	}
+
+	return []TracerOpts{
+		{Audience: "default", Endpoint: privateEndpoint},
+		{Audience: "public", Endpoint: publicEndpoint},
+	}
+}
+
+// OTLP exporter protocols selectable via OTEL_EXPORTER_OTLP_PROTOCOL.
+const (
+	protocolGRPC   = "grpc"
+	protocolHTTP   = "http/protobuf"
+	protocolStdout = "stdout"
+)
+
+type exporterConfig struct {
+	protocol    string
+	endpoint    string
+	certificate string
+	insecure    bool
+	headers     map[string]string
+}
+
+func newExporterConfig(endpoint string) exporterConfig {
+	protocol := os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL")
+	if protocol == "" {
+		protocol = protocolGRPC
+	}
+
+	return exporterConfig{
+		protocol:    protocol,
+		endpoint:    endpoint,
+		certificate: os.Getenv("OTEL_EXPORTER_OTLP_CERTIFICATE"),
+		insecure:    os.Getenv("OTEL_EXPORTER_OTLP_INSECURE") == "true",
+		headers:     parseOTLPHeaders(os.Getenv("OTEL_EXPORTER_OTLP_HEADERS")),
+	}
+}
+
+// resolveExporterConfig resolves a per-signal endpoint override (e.g.
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT), falling back to fallbackEndpoint - the
+// caller's own resolution of the shared OTEL_EXPORTER_OTLP_ENDPOINT, not
+// re-read here.
+func resolveExporterConfig(signalEndpointEnvVar, fallbackEndpoint string) exporterConfig {
+	endpoint := os.Getenv(signalEndpointEnvVar)
 	if endpoint == "" {
-		endpoint = "localhost:4317"
+		endpoint = fallbackEndpoint
 	}
+	return newExporterConfig(endpoint)
+}
 
-	exporter, err := otlptracegrpc.New(ctx,
-		otlptracegrpc.WithEndpoint(endpoint),
-		otlptracegrpc.WithInsecure(),
-	)
+func commonEndpointOrDefault(def string) string {
+	if endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"); endpoint != "" {
+		return endpoint
+	}
+	return def
+}
+
+// parseOTLPHeaders parses the "key1=value1,key2=value2" format of OTEL_EXPORTER_OTLP_HEADERS.
+func parseOTLPHeaders(raw string) map[string]string {
+	if raw == "" {
+		return nil
+	}
+
+	headers := make(map[string]string)
+	for _, pair := range strings.Split(raw, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		headers[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return headers
+}
+
+// tlsCredentialsFromCertificate falls back to the system root CAs if certificate is empty.
+func tlsCredentialsFromCertificate(certificate string) (credentials.TransportCredentials, error) {
+	if certificate == "" {
+		return credentials.NewTLS(&tls.Config{}), nil
+	}
+
+	creds, err := credentials.NewClientTLSFromFile(certificate, "")
 	if err != nil {
-		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+		return nil, fmt.Errorf("failed to load OTLP TLS certificate %q: %w", certificate, err)
 	}
+	return creds, nil
+}
 
-	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(exporter),
-		sdktrace.WithResource(res),
-	)
+func grpcCredentials(cfg exporterConfig) (credentials.TransportCredentials, error) {
+	if cfg.insecure {
+		return insecure.NewCredentials(), nil
+	}
+	return tlsCredentialsFromCertificate(cfg.certificate)
+}
 
-	otel.SetTracerProvider(tp)
-	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
-		propagation.TraceContext{},
-		propagation.Baggage{},
-	))
+// tlsConfigFromCertificate falls back to the system root CAs if certificate is empty.
+func tlsConfigFromCertificate(certificate string) (*tls.Config, error) {
+	if certificate == "" {
+		return &tls.Config{}, nil
+	}
 
-	tracer = tp.Tracer("service-a")
+	pem, err := os.ReadFile(certificate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OTLP TLS certificate %q: %w", certificate, err)
+	}
 
-	return tp, nil
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("failed to parse OTLP TLS certificate %q", certificate)
+	}
+
+	return &tls.Config{RootCAs: pool}, nil
 }
 
-func initLogger(ctx context.Context, res *resource.Resource) (*sdklog.LoggerProvider, error) {
-	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
-	if endpoint == "" {
-		endpoint = "localhost:4317"
+// httpTLSConfig returns nil if OTEL_EXPORTER_OTLP_INSECURE opted out of TLS entirely.
+func httpTLSConfig(cfg exporterConfig) (*tls.Config, error) {
+	if cfg.insecure {
+		return nil, nil
 	}
+	return tlsConfigFromCertificate(cfg.certificate)
+}
 
-	exporter, err := otlploggrpc.New(ctx,
-		otlploggrpc.WithEndpoint(endpoint),
-		otlploggrpc.WithInsecure(),
-	)
+func newTraceExporter(ctx context.Context, cfg exporterConfig) (sdktrace.SpanExporter, error) {
+	switch cfg.protocol {
+	case protocolStdout:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+
+	case protocolHTTP:
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.endpoint)}
+		tlsCfg, err := httpTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		if tlsCfg != nil {
+			opts = append(opts, otlptracehttp.WithTLSClientConfig(tlsCfg))
+		} else {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		if len(cfg.headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(cfg.headers))
+		}
+		return otlptracehttp.New(ctx, opts...)
+
+	default:
+		creds, err := grpcCredentials(cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(cfg.endpoint),
+			otlptracegrpc.WithTLSCredentials(creds),
+		}
+		if len(cfg.headers) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(cfg.headers))
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	}
+}
+
+func newLogExporter(ctx context.Context, cfg exporterConfig) (sdklog.Exporter, error) {
+	switch cfg.protocol {
+	case protocolStdout:
+		return stdoutlog.New()
+
+	case protocolHTTP:
+		opts := []otlploghttp.Option{otlploghttp.WithEndpoint(cfg.endpoint)}
+		tlsCfg, err := httpTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		if tlsCfg != nil {
+			opts = append(opts, otlploghttp.WithTLSClientConfig(tlsCfg))
+		} else {
+			opts = append(opts, otlploghttp.WithInsecure())
+		}
+		if len(cfg.headers) > 0 {
+			opts = append(opts, otlploghttp.WithHeaders(cfg.headers))
+		}
+		return otlploghttp.New(ctx, opts...)
+
+	default:
+		creds, err := grpcCredentials(cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts := []otlploggrpc.Option{
+			otlploggrpc.WithEndpoint(cfg.endpoint),
+			otlploggrpc.WithTLSCredentials(creds),
+		}
+		if len(cfg.headers) > 0 {
+			opts = append(opts, otlploggrpc.WithHeaders(cfg.headers))
+		}
+		return otlploggrpc.New(ctx, opts...)
+	}
+}
+
+func newMetricExporter(ctx context.Context, cfg exporterConfig) (sdkmetric.Exporter, error) {
+	switch cfg.protocol {
+	case protocolStdout:
+		return stdoutmetric.New()
+
+	case protocolHTTP:
+		opts := []otlpmetrichttp.Option{otlpmetrichttp.WithEndpoint(cfg.endpoint)}
+		tlsCfg, err := httpTLSConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
+		if tlsCfg != nil {
+			opts = append(opts, otlpmetrichttp.WithTLSClientConfig(tlsCfg))
+		} else {
+			opts = append(opts, otlpmetrichttp.WithInsecure())
+		}
+		if len(cfg.headers) > 0 {
+			opts = append(opts, otlpmetrichttp.WithHeaders(cfg.headers))
+		}
+		return otlpmetrichttp.New(ctx, opts...)
+
+	default:
+		creds, err := grpcCredentials(cfg)
+		if err != nil {
+			return nil, err
+		}
+		opts := []otlpmetricgrpc.Option{
+			otlpmetricgrpc.WithEndpoint(cfg.endpoint),
+			otlpmetricgrpc.WithTLSCredentials(creds),
+		}
+		if len(cfg.headers) > 0 {
+			opts = append(opts, otlpmetricgrpc.WithHeaders(cfg.headers))
+		}
+		return otlpmetricgrpc.New(ctx, opts...)
+	}
+}
+
+// TracerRouter holds one TracerProvider per audience, picked via Tracer(name, audience).
+type TracerRouter struct {
+	providers map[string]*sdktrace.TracerProvider
+}
+
+// Tracer falls back to the "default" audience if audience isn't registered.
+func (r *TracerRouter) Tracer(name, audience string) trace.Tracer {
+	tp, ok := r.providers[audience]
+	if !ok {
+		tp = r.providers["default"]
+	}
+	return tp.Tracer(name)
+}
+
+func (r *TracerRouter) shutdown(ctx context.Context) {
+	for audience, tp := range r.providers {
+		if err := tp.Shutdown(ctx); err != nil {
+			log.Printf("Error shutting down %s tracer provider: %v", audience, err)
+		}
+	}
+}
+
+// forceFlush is mainly useful in tests, where waiting out the batch span processor would be slow.
+func (r *TracerRouter) forceFlush(ctx context.Context) {
+	for audience, tp := range r.providers {
+		if err := tp.ForceFlush(ctx); err != nil {
+			log.Printf("Error flushing %s tracer provider: %v", audience, err)
+		}
+	}
+}
+
+func initTelemetry(ctx context.Context, res *resource.Resource, tracerOpts []TracerOpts) (*TracerRouter, *sdklog.LoggerProvider, *sdkmetric.MeterProvider, error) {
+	// --- Tracing: one TracerProvider per audience ---
+	tracerProviders := make(map[string]*sdktrace.TracerProvider, len(tracerOpts))
+	for _, opt := range tracerOpts {
+		exporter, err := newTraceExporter(ctx, resolveExporterConfig("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", opt.Endpoint))
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to create trace exporter for %q audience: %w", opt.Audience, err)
+		}
+		tracerProviders[opt.Audience] = sdktrace.NewTracerProvider(
+			sdktrace.WithSpanProcessor(sdktrace.NewBatchSpanProcessor(exporter)),
+			sdktrace.WithResource(res),
+		)
+	}
+
+	if defaultTP, ok := tracerProviders["default"]; ok {
+		otel.SetTracerProvider(defaultTP)
+	}
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	// --- Logging ---
+	logExporter, err := newLogExporter(ctx, resolveExporterConfig("OTEL_EXPORTER_OTLP_LOGS_ENDPOINT", commonEndpointOrDefault("localhost:4317")))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create OTLP log exporter: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create log exporter: %w", err)
 	}
 
 	lp := sdklog.NewLoggerProvider(
-		sdklog.WithProcessor(sdklog.NewBatchProcessor(exporter)),
+		sdklog.WithProcessor(sdklog.NewBatchProcessor(logExporter)),
 		sdklog.WithResource(res),
 	)
 
@@ -323,25 +877,14 @@ func initLogger(ctx context.Context, res *resource.Resource) (*sdklog.LoggerProv
 	// Create an slog handler that bridges to OTel
 	logger = otelslog.NewLogger("service-a")
 
-	return lp, nil
-}
-
-func initMetrics(ctx context.Context, res *resource.Resource) (*sdkmetric.MeterProvider, error) {
-	endpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
-	if endpoint == "" {
-		endpoint = "localhost:4317"
-	}
-
-	exporter, err := otlpmetricgrpc.New(ctx,
-		otlpmetricgrpc.WithEndpoint(endpoint),
-		otlpmetricgrpc.WithInsecure(),
-	)
+	// --- Metrics ---
+	metricExporter, err := newMetricExporter(ctx, resolveExporterConfig("OTEL_EXPORTER_OTLP_METRICS_ENDPOINT", commonEndpointOrDefault("localhost:4317")))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create OTLP metric exporter: %w", err)
+		return nil, nil, nil, fmt.Errorf("failed to create metric exporter: %w", err)
 	}
 
 	mp := sdkmetric.NewMeterProvider(
-		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter, sdkmetric.WithInterval(10*time.Second))),
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExporter, sdkmetric.WithInterval(10*time.Second))),
 		sdkmetric.WithResource(res),
 	)
 
@@ -354,17 +897,149 @@ func initMetrics(ctx context.Context, res *resource.Resource) (*sdkmetric.MeterP
 	requestCounter, metricErr = meter.Int64Counter("service_a_requests_total",
 		metric.WithDescription("Total number of requests processed"))
 	if metricErr != nil {
-		return nil, fmt.Errorf("failed to create request counter: %w", metricErr)
+		return nil, nil, nil, fmt.Errorf("failed to create request counter: %w", metricErr)
 	}
 
 	latencyRecorder, metricErr = meter.Float64Histogram("service_a_request_duration_ms",
 		metric.WithDescription("Request duration in milliseconds"),
 		metric.WithUnit("ms"))
 	if metricErr != nil {
-		return nil, fmt.Errorf("failed to create latency histogram: %w", metricErr)
+		return nil, nil, nil, fmt.Errorf("failed to create latency histogram: %w", metricErr)
+	}
+
+	return &TracerRouter{providers: tracerProviders}, lp, mp, nil
+}
+
+// initClientMetricsProvider returns nil if SERVICE_A_CLIENT_METRICS_ENDPOINT isn't set.
+func initClientMetricsProvider(ctx context.Context, res *resource.Resource) (*sdkmetric.MeterProvider, error) {
+	endpoint := os.Getenv("SERVICE_A_CLIENT_METRICS_ENDPOINT")
+	if endpoint == "" {
+		return nil, nil
+	}
+
+	exporter, err := newMetricExporter(ctx, newExporterConfig(endpoint))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create client metrics exporter: %w", err)
+	}
+
+	return sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exporter, sdkmetric.WithInterval(10*time.Second))),
+		sdkmetric.WithResource(res),
+	), nil
+}
+
+type telemetryProviders struct {
+	tracers *TracerRouter
+	logger  *sdklog.LoggerProvider
+	meter   *sdkmetric.MeterProvider
+
+	// clientMeter is only set when SERVICE_A_CLIENT_METRICS_ENDPOINT is configured.
+	clientMeter *sdkmetric.MeterProvider
+}
+
+func (p *telemetryProviders) shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownFlushTimeout)
+	defer cancel()
+
+	p.tracers.shutdown(ctx)
+	if err := p.logger.Shutdown(ctx); err != nil {
+		log.Printf("Error shutting down logger provider: %v", err)
+	}
+	if err := p.meter.Shutdown(ctx); err != nil {
+		log.Printf("Error shutting down meter provider: %v", err)
+	}
+	if p.clientMeter != nil {
+		if err := p.clientMeter.Shutdown(ctx); err != nil {
+			log.Printf("Error shutting down client metrics meter provider: %v", err)
+		}
+	}
+}
+
+// listen inherits the listener fd from a hot-reload parent, if any, instead of binding fresh.
+func listen(port string) (net.Listener, error) {
+	if _, ok := os.LookupEnv(reexecListenerFDEnv); ok {
+		f := os.NewFile(3, "listener")
+		lis, err := net.FileListener(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to inherit listener from fd 3: %w", err)
+		}
+		f.Close()
+		log.Println("[Service A] Inherited listener from parent process (hot-reload)")
+		return lis, nil
+	}
+
+	return net.Listen("tcp", fmt.Sprintf(":%s", port))
+}
+
+// reexecWithListener re-execs the binary, handing the listener to the child on fd 3.
+func reexecWithListener(lis net.Listener) error {
+	tcpLis, ok := lis.(*net.TCPListener)
+	if !ok {
+		return fmt.Errorf("listener is not a *net.TCPListener, cannot pass its fd to a child")
+	}
+
+	lisFile, err := tcpLis.File()
+	if err != nil {
+		return fmt.Errorf("failed to get listener file: %w", err)
+	}
+	defer lisFile.Close()
+
+	execPath, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to resolve executable path: %w", err)
+	}
+
+	cmd := exec.Command(execPath, os.Args[1:]...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = append(os.Environ(), reexecListenerFDEnv+"=3")
+	cmd.ExtraFiles = []*os.File{lisFile}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start reloaded child process: %w", err)
+	}
+
+	log.Printf("[Service A] Re-exec'd child pid=%d to take over listener fd", cmd.Process.Pid)
+	return nil
+}
+
+// awaitShutdown blocks for a terminating or reload signal, then stops the
+// server accordingly (SIGQUIT immediately, SIGHUP/SIGUSR2 via hot-reload,
+// everything else gracefully) and flushes telemetry before returning.
+func awaitShutdown(grpcServer *grpc.Server, srv *server, lis net.Listener, providers *telemetryProviders) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGTERM, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGHUP, syscall.SIGUSR2)
+
+	sig := <-sigCh
+	log.Printf("[Service A] Received signal %v", sig)
+
+	switch sig {
+	case syscall.SIGQUIT:
+		log.Println("[Service A] Stopping immediately, dropping in-flight RPCs")
+		grpcServer.Stop()
+
+	case syscall.SIGHUP, syscall.SIGUSR2:
+		log.Println("[Service A] Hot-reload requested, re-exec'ing with inherited listener")
+		if err := reexecWithListener(lis); err != nil {
+			log.Printf("[Service A] Hot-reload failed, falling back to graceful stop: %v", err)
+		}
+		log.Printf("[Service A] Draining %d active iteration(s) before exit", atomic.LoadInt64(&srv.activeIterations))
+		grpcServer.GracefulStop()
+
+	default: // SIGTERM, SIGINT
+		log.Printf("[Service A] Graceful shutdown requested, draining %d active iteration(s)", atomic.LoadInt64(&srv.activeIterations))
+		grpcServer.GracefulStop()
 	}
 
-	return mp, nil
+	// GracefulStop only waits for in-flight RPCs; iterations running outside an
+	// RPC (the startup workload) are waited for here before telemetry is flushed.
+	if sig != syscall.SIGQUIT {
+		for atomic.LoadInt64(&srv.activeIterations) > 0 {
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	providers.shutdown()
 }
 
 func main() {
@@ -378,38 +1053,25 @@ func main() {
 		log.Fatalf("Failed to create resource: %v", err)
 	}
 
-	// Initialize tracer
-	tp, err := initTracer(ctx, res)
+	// Initialize tracers, logger, and metrics together
+	var lp *sdklog.LoggerProvider
+	var mp *sdkmetric.MeterProvider
+	tracers, lp, mp, err = initTelemetry(ctx, res, defaultTracerOpts())
 	if err != nil {
-		log.Fatalf("Failed to initialize tracer: %v", err)
+		log.Fatalf("Failed to initialize telemetry: %v", err)
 	}
-	defer func() {
-		if err := tp.Shutdown(ctx); err != nil {
-			log.Printf("Error shutting down tracer provider: %v", err)
-		}
-	}()
 
-	// Initialize logger
-	lp, err := initLogger(ctx, res)
+	// Client-side downstream call metrics can optionally go to their own backend
+	clientMP, err := initClientMetricsProvider(ctx, res)
 	if err != nil {
-		log.Fatalf("Failed to initialize logger: %v", err)
+		log.Fatalf("Failed to initialize client metrics provider: %v", err)
 	}
-	defer func() {
-		if err := lp.Shutdown(ctx); err != nil {
-			log.Printf("Error shutting down logger provider: %v", err)
-		}
-	}()
-
-	// Initialize metrics
-	mp, err := initMetrics(ctx, res)
-	if err != nil {
-		log.Fatalf("Failed to initialize metrics: %v", err)
+	clientMeterProvider := metric.MeterProvider(mp)
+	if clientMP != nil {
+		clientMeterProvider = clientMP
 	}
-	defer func() {
-		if err := mp.Shutdown(ctx); err != nil {
-			log.Printf("Error shutting down meter provider: %v", err)
-		}
-	}()
+
+	providers := &telemetryProviders{tracers: tracers, logger: lp, meter: mp, clientMeter: clientMP}
 
 	port := os.Getenv("GRPC_PORT")
 	if port == "" {
@@ -430,14 +1092,14 @@ func main() {
 	log.Printf("[Service A] Service B address: %s", serviceBAddr)
 	log.Printf("[Service A] Service C address: %s", serviceCAddr)
 
-	srv, err := newServer(serviceBAddr, serviceCAddr)
+	srv, err := newServer(serviceBAddr, serviceCAddr, clientMeterProvider.Meter("service-a-client"))
 	if err != nil {
 		log.Fatalf("Failed to create server: %v", err)
 	}
 	defer srv.serviceBConn.Close()
 	defer srv.serviceCConn.Close()
 
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
+	lis, err := listen(port)
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
@@ -450,28 +1112,37 @@ func main() {
 	log.Println("[Service A] Entry point service (Go) ready")
 	log.Println("[Service A] Waiting for TriggerWorkload calls...")
 
-	// Auto-trigger workload on startup after a delay
-	go func() {
-		time.Sleep(5 * time.Second) // Wait for other services to be ready
-		log.Println("[Service A] Auto-triggering startup workload (50 iterations)...")
-
-		ctx, span := tracer.Start(context.Background(), "startup-workload")
-		defer span.End()
+	// Auto-trigger workload on startup after a delay, but not on a hot-reloaded child
+	if _, reexeced := os.LookupEnv(reexecListenerFDEnv); !reexeced {
+		go func() {
+			time.Sleep(5 * time.Second) // Wait for other services to be ready
+			log.Println("[Service A] Auto-triggering startup workload (50 iterations)...")
+
+			ctx, span := tracers.Tracer("service-a", "public").Start(context.Background(), "startup-workload",
+				trace.WithAttributes(attribute.String(spanAudienceKey, "public")),
+			)
+			defer span.End()
+
+			req := &pb.WorkloadRequest{
+				Iterations: 50,
+			}
+			resp, err := srv.TriggerWorkload(ctx, req)
+			if err != nil {
+				logWithContext(ctx, "ERROR", "Startup workload error: %v", err)
+				span.RecordError(err)
+			} else {
+				logWithContext(ctx, "INFO", "Startup workload complete: %d/%d successful",
+					resp.SuccessfulIterations, resp.SuccessfulIterations+resp.FailedIterations)
+			}
+		}()
+	}
 
-		req := &pb.WorkloadRequest{
-			Iterations: 50,
-		}
-		resp, err := srv.TriggerWorkload(ctx, req)
-		if err != nil {
-			logWithContext(ctx, "ERROR", "Startup workload error: %v", err)
-			span.RecordError(err)
-		} else {
-			logWithContext(ctx, "INFO", "Startup workload complete: %d/%d successful",
-				resp.SuccessfulIterations, resp.SuccessfulIterations+resp.FailedIterations)
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			log.Fatalf("Failed to serve: %v", err)
 		}
 	}()
 
-	if err := grpcServer.Serve(lis); err != nil {
-		log.Fatalf("Failed to serve: %v", err)
-	}
+	awaitShutdown(grpcServer, srv, lis, providers)
+	log.Println("[Service A] Shutdown complete")
 }
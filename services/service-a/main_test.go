@@ -0,0 +1,444 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"service-a/testutil"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/metric"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	pb "service-a/proto"
+)
+
+// fakeServiceB and fakeServiceC stand in for the real downstream services so
+// the integration tests can exercise Service A's full TriggerWorkload path
+// (tracing, metrics, gRPC) without depending on services/service-b or
+// services/service-c actually running.
+
+type fakeServiceB struct {
+	pb.UnimplementedServiceBServer
+	fail bool
+	// failCode is the gRPC status code ProcessData fails with when fail is
+	// set; codes.OK (the zero value) falls back to a plain, code-less error.
+	failCode codes.Code
+}
+
+func (f *fakeServiceB) ProcessData(ctx context.Context, req *pb.ProcessRequest) (*pb.ProcessResponse, error) {
+	if f.fail {
+		if f.failCode != codes.OK {
+			return nil, status.Error(f.failCode, "simulated Service B failure")
+		}
+		return nil, fmt.Errorf("simulated Service B failure")
+	}
+	return &pb.ProcessResponse{Status: &pb.ResponseStatus{Success: true}}, nil
+}
+
+type fakeServiceC struct {
+	pb.UnimplementedServiceCServer
+}
+
+func (f *fakeServiceC) RunAnalytics(ctx context.Context, req *pb.AnalyticsRequest) (*pb.AnalyticsResponse, error) {
+	return &pb.AnalyticsResponse{Status: &pb.ResponseStatus{Success: true}}, nil
+}
+
+// startFakeDownstream starts an in-process gRPC server standing in for both
+// Service B and Service C and returns its address.
+func startFakeDownstream(t *testing.T, serviceB *fakeServiceB) string {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	srv := grpc.NewServer()
+	pb.RegisterServiceBServer(srv, serviceB)
+	pb.RegisterServiceCServer(srv, &fakeServiceC{})
+
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	return lis.Addr().String()
+}
+
+// setupTestTelemetry points the package-level tracers/logger/meter at a mock
+// OTLP collector for the duration of the test. If publicCollectorAddr is
+// non-empty, the "public" audience is routed to it instead of collectorAddr,
+// so tests can assert on the public/private trace split from chunk0-3.
+func setupTestTelemetry(t *testing.T, collectorAddr, publicCollectorAddr string) *sdkmetric.MeterProvider {
+	t.Helper()
+
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", collectorAddr)
+	t.Setenv("OTEL_EXPORTER_OTLP_PUBLIC_ENDPOINT", publicCollectorAddr)
+	t.Setenv("OTEL_EXPORTER_OTLP_PROTOCOL", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_INSECURE", "true")
+
+	ctx := context.Background()
+	res, err := newResource(ctx)
+	if err != nil {
+		t.Fatalf("failed to create resource: %v", err)
+	}
+
+	var lp *sdklog.LoggerProvider
+	var mp *sdkmetric.MeterProvider
+	tracers, lp, mp, err = initTelemetry(ctx, res, defaultTracerOpts())
+	if err != nil {
+		t.Fatalf("failed to init telemetry: %v", err)
+	}
+
+	t.Cleanup(func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		tracers.shutdown(ctx)
+		lp.Shutdown(ctx)
+		mp.Shutdown(ctx)
+	})
+
+	return mp
+}
+
+// startTestServiceA wires a *server up against downstreamAddr and serves it
+// over a real in-process gRPC server, returning a client to it.
+func startTestServiceA(t *testing.T, downstreamAddr string, clientMeter metric.Meter) pb.ServiceAClient {
+	t.Helper()
+
+	srv, err := newServer(downstreamAddr, downstreamAddr, clientMeter)
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+	t.Cleanup(func() {
+		srv.serviceBConn.Close()
+		srv.serviceCConn.Close()
+	})
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+
+	grpcServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
+	pb.RegisterServiceAServer(grpcServer, srv)
+
+	go grpcServer.Serve(lis)
+	t.Cleanup(grpcServer.Stop)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("failed to dial Service A: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return pb.NewServiceAClient(conn)
+}
+
+// drainTraceRequests collects every span the mock collector has received so
+// far, keyed by span name, waiting briefly for any in-flight exports.
+func drainTraceRequests(collector *testutil.MockCollector) map[string][]*tracepb.Span {
+	spansByName := make(map[string][]*tracepb.Span)
+	for {
+		select {
+		case req := <-collector.TraceRequests:
+			for _, rs := range req.ResourceSpans {
+				for _, ss := range rs.ScopeSpans {
+					for _, span := range ss.Spans {
+						spansByName[span.Name] = append(spansByName[span.Name], span)
+					}
+				}
+			}
+		case <-time.After(500 * time.Millisecond):
+			return spansByName
+		}
+	}
+}
+
+func TestTriggerWorkloadProducesSpanTree(t *testing.T) {
+	collector, err := testutil.NewMockCollector()
+	if err != nil {
+		t.Fatalf("failed to start mock collector: %v", err)
+	}
+	defer collector.Close()
+
+	mp := setupTestTelemetry(t, collector.Addr, "")
+	downstreamAddr := startFakeDownstream(t, &fakeServiceB{})
+	client := startTestServiceA(t, downstreamAddr, mp.Meter("service-a-client-test"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	const iterations = 3
+	resp, err := client.TriggerWorkload(ctx, &pb.WorkloadRequest{Iterations: iterations})
+	if err != nil {
+		t.Fatalf("TriggerWorkload returned error: %v", err)
+	}
+	if resp.SuccessfulIterations != iterations {
+		t.Fatalf("expected %d successful iterations, got %d", iterations, resp.SuccessfulIterations)
+	}
+
+	tracers.forceFlush(ctx)
+	spansByName := drainTraceRequests(collector)
+
+	if got := len(spansByName["TriggerWorkload"]); got != 1 {
+		t.Fatalf("expected exactly one TriggerWorkload span, got %d", got)
+	}
+	if got := len(spansByName["workload-iteration-1"]); got != 1 {
+		t.Fatalf("expected exactly one workload-iteration-1 span, got %d", got)
+	}
+	if got := len(spansByName["call-service-b"]); got != iterations {
+		t.Fatalf("expected %d call-service-b spans, got %d", iterations, got)
+	}
+	if got := len(spansByName["call-service-c"]); got != iterations {
+		t.Fatalf("expected %d call-service-c spans, got %d", iterations, got)
+	}
+
+	// Trace context must propagate end-to-end: every call-service-b/c span
+	// should share the TriggerWorkload span's trace ID.
+	rootTraceID := spansByName["TriggerWorkload"][0].TraceId
+	for _, name := range []string{"call-service-b", "call-service-c"} {
+		for _, span := range spansByName[name] {
+			if string(span.TraceId) != string(rootTraceID) {
+				t.Errorf("%s span has trace ID %x, want %x (trace context did not propagate)", name, span.TraceId, rootTraceID)
+			}
+		}
+	}
+}
+
+func TestTriggerWorkloadEmitsRequestMetrics(t *testing.T) {
+	collector, err := testutil.NewMockCollector()
+	if err != nil {
+		t.Fatalf("failed to start mock collector: %v", err)
+	}
+	defer collector.Close()
+
+	mp := setupTestTelemetry(t, collector.Addr, "")
+	downstreamAddr := startFakeDownstream(t, &fakeServiceB{})
+	client := startTestServiceA(t, downstreamAddr, mp.Meter("service-a-client-test"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := client.TriggerWorkload(ctx, &pb.WorkloadRequest{Iterations: 1}); err != nil {
+		t.Fatalf("TriggerWorkload returned error: %v", err)
+	}
+
+	if err := mp.ForceFlush(ctx); err != nil {
+		t.Fatalf("failed to flush meter provider: %v", err)
+	}
+
+	var metricNames []string
+	found := map[string]bool{}
+collect:
+	for {
+		select {
+		case req := <-collector.MetricRequests:
+			for _, rm := range req.ResourceMetrics {
+				for _, sm := range rm.ScopeMetrics {
+					for _, m := range sm.Metrics {
+						metricNames = append(metricNames, m.Name)
+						if m.Name == "service_a_requests_total" || m.Name == "service_a_request_duration_ms" {
+							found[m.Name] = true
+						}
+					}
+				}
+			}
+		case <-time.After(500 * time.Millisecond):
+			break collect
+		}
+	}
+
+	for _, want := range []string{"service_a_requests_total", "service_a_request_duration_ms"} {
+		if !found[want] {
+			t.Errorf("expected metric %q to be exported, saw %v", want, metricNames)
+		}
+	}
+}
+
+func TestRunIterationFailureRecordsSpanError(t *testing.T) {
+	collector, err := testutil.NewMockCollector()
+	if err != nil {
+		t.Fatalf("failed to start mock collector: %v", err)
+	}
+	defer collector.Close()
+
+	mp := setupTestTelemetry(t, collector.Addr, "")
+	downstreamAddr := startFakeDownstream(t, &fakeServiceB{fail: true})
+	client := startTestServiceA(t, downstreamAddr, mp.Meter("service-a-client-test"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.TriggerWorkload(ctx, &pb.WorkloadRequest{Iterations: 1})
+	if err != nil {
+		t.Fatalf("TriggerWorkload returned error: %v", err)
+	}
+	if resp.FailedIterations != 1 {
+		t.Fatalf("expected 1 failed iteration, got %d", resp.FailedIterations)
+	}
+
+	tracers.forceFlush(ctx)
+	spansByName := drainTraceRequests(collector)
+
+	bSpans := spansByName["call-service-b"]
+	if len(bSpans) != 1 {
+		t.Fatalf("expected exactly one call-service-b span, got %d", len(bSpans))
+	}
+
+	hasExceptionEvent := false
+	for _, event := range bSpans[0].Events {
+		if event.Name == "exception" {
+			hasExceptionEvent = true
+		}
+	}
+	if !hasExceptionEvent {
+		t.Error("expected call-service-b span to have a RecordError exception event")
+	}
+}
+
+func TestServiceFunctionsWithUnreachableCollector(t *testing.T) {
+	// Bind and immediately close a listener to get a port nothing is
+	// listening on, simulating a misconfigured/unreachable collector.
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to reserve a port: %v", err)
+	}
+	unreachableAddr := lis.Addr().String()
+	lis.Close()
+
+	mp := setupTestTelemetry(t, unreachableAddr, "")
+	downstreamAddr := startFakeDownstream(t, &fakeServiceB{})
+	client := startTestServiceA(t, downstreamAddr, mp.Meter("service-a-client-test"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := client.TriggerWorkload(ctx, &pb.WorkloadRequest{Iterations: 1})
+	if err != nil {
+		t.Fatalf("TriggerWorkload should succeed even when the collector is unreachable, got error: %v", err)
+	}
+	if resp.SuccessfulIterations != 1 {
+		t.Fatalf("expected 1 successful iteration, got %d", resp.SuccessfulIterations)
+	}
+	// The batch exporters retry/fail in the background against the
+	// unreachable collector; that must never block or fail TriggerWorkload.
+}
+
+// TestAudienceSplitRoutesSpansToTheirOwnCollector exercises chunk0-3's
+// entire reason for existing: TriggerWorkload/workload-iteration spans
+// (audience "public") must land on the public collector, while
+// call-service-b/call-service-c spans (audience "default") must land on
+// the private one, even though both collectors are live and reachable.
+func TestAudienceSplitRoutesSpansToTheirOwnCollector(t *testing.T) {
+	privateCollector, err := testutil.NewMockCollector()
+	if err != nil {
+		t.Fatalf("failed to start private mock collector: %v", err)
+	}
+	defer privateCollector.Close()
+
+	publicCollector, err := testutil.NewMockCollector()
+	if err != nil {
+		t.Fatalf("failed to start public mock collector: %v", err)
+	}
+	defer publicCollector.Close()
+
+	mp := setupTestTelemetry(t, privateCollector.Addr, publicCollector.Addr)
+	downstreamAddr := startFakeDownstream(t, &fakeServiceB{})
+	client := startTestServiceA(t, downstreamAddr, mp.Meter("service-a-client-test"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := client.TriggerWorkload(ctx, &pb.WorkloadRequest{Iterations: 1}); err != nil {
+		t.Fatalf("TriggerWorkload returned error: %v", err)
+	}
+
+	tracers.forceFlush(ctx)
+	privateSpans := drainTraceRequests(privateCollector)
+	publicSpans := drainTraceRequests(publicCollector)
+
+	for _, name := range []string{"TriggerWorkload", "workload-iteration-1"} {
+		if got := len(publicSpans[name]); got != 1 {
+			t.Errorf("expected %q span on the public collector, got %d", name, got)
+		}
+		if got := len(privateSpans[name]); got != 0 {
+			t.Errorf("expected %q span NOT to reach the private collector, got %d", name, got)
+		}
+	}
+
+	for _, name := range []string{"call-service-b", "call-service-c"} {
+		if got := len(privateSpans[name]); got != 1 {
+			t.Errorf("expected %q span on the private collector, got %d", name, got)
+		}
+		if got := len(publicSpans[name]); got != 0 {
+			t.Errorf("expected %q span NOT to reach the public collector, got %d", name, got)
+		}
+	}
+}
+
+func TestCallDownstreamRetriesRetryableFailures(t *testing.T) {
+	collector, err := testutil.NewMockCollector()
+	if err != nil {
+		t.Fatalf("failed to start mock collector: %v", err)
+	}
+	defer collector.Close()
+
+	mp := setupTestTelemetry(t, collector.Addr, "")
+	downstreamAddr := startFakeDownstream(t, &fakeServiceB{fail: true, failCode: codes.Unavailable})
+	client := startTestServiceA(t, downstreamAddr, mp.Meter("service-a-client-test"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := client.TriggerWorkload(ctx, &pb.WorkloadRequest{Iterations: 1}); err != nil {
+		t.Fatalf("TriggerWorkload returned error: %v", err)
+	}
+
+	tracers.forceFlush(ctx)
+	spansByName := drainTraceRequests(collector)
+
+	if got := len(spansByName["call-service-b/attempt-1"]); got != 1 {
+		t.Fatalf("expected 1 call-service-b/attempt-1 span, got %d", got)
+	}
+	if got := len(spansByName["call-service-b/attempt-2"]); got != 1 {
+		t.Errorf("expected a retryable failure to produce a call-service-b/attempt-2 span, got %d", got)
+	}
+}
+
+func TestCallDownstreamDoesNotRetryNonRetryableFailures(t *testing.T) {
+	collector, err := testutil.NewMockCollector()
+	if err != nil {
+		t.Fatalf("failed to start mock collector: %v", err)
+	}
+	defer collector.Close()
+
+	mp := setupTestTelemetry(t, collector.Addr, "")
+	downstreamAddr := startFakeDownstream(t, &fakeServiceB{fail: true, failCode: codes.InvalidArgument})
+	client := startTestServiceA(t, downstreamAddr, mp.Meter("service-a-client-test"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := client.TriggerWorkload(ctx, &pb.WorkloadRequest{Iterations: 1}); err != nil {
+		t.Fatalf("TriggerWorkload returned error: %v", err)
+	}
+
+	tracers.forceFlush(ctx)
+	spansByName := drainTraceRequests(collector)
+
+	if got := len(spansByName["call-service-b/attempt-1"]); got != 1 {
+		t.Fatalf("expected 1 call-service-b/attempt-1 span, got %d", got)
+	}
+	if got := len(spansByName["call-service-b/attempt-2"]); got != 0 {
+		t.Errorf("expected a non-retryable failure NOT to produce a call-service-b/attempt-2 span, got %d", got)
+	}
+}
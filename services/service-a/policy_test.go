@@ -0,0 +1,115 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"service-a/testutil"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestIsRetryableError(t *testing.T) {
+	retryableCodes := []codes.Code{codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil error", nil, false},
+		{"retryable code", status.Error(codes.Unavailable, "down"), true},
+		{"another retryable code", status.Error(codes.ResourceExhausted, "throttled"), true},
+		{"non-retryable code", status.Error(codes.InvalidArgument, "bad request"), false},
+		{"plain error has codes.Unknown, not retryable", errors.New("boom"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryableError(tt.err, retryableCodes); got != tt.want {
+				t.Errorf("isRetryableError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBackoffWithJitterCapsAtBackoffMax(t *testing.T) {
+	policy := CallPolicy{
+		BackoffBase: 50 * time.Millisecond,
+		BackoffMax:  200 * time.Millisecond,
+	}
+
+	// Attempt 5 would be 50ms*2^4 = 800ms uncapped; it must be capped at
+	// BackoffMax plus up to 20% jitter.
+	for i := 0; i < 20; i++ {
+		d := backoffWithJitter(policy, 5)
+		if d < policy.BackoffMax || d > policy.BackoffMax+policy.BackoffMax/5 {
+			t.Fatalf("backoffWithJitter(attempt=5) = %v, want between %v and %v", d, policy.BackoffMax, policy.BackoffMax+policy.BackoffMax/5)
+		}
+	}
+}
+
+func TestBackoffWithJitterGrowsExponentiallyBeforeCap(t *testing.T) {
+	policy := CallPolicy{
+		BackoffBase: 10 * time.Millisecond,
+		BackoffMax:  10 * time.Second,
+	}
+
+	for attempt, base := range map[int]time.Duration{
+		1: 10 * time.Millisecond,
+		2: 20 * time.Millisecond,
+		3: 40 * time.Millisecond,
+	} {
+		d := backoffWithJitter(policy, attempt)
+		if d < base || d > base+base/5 {
+			t.Errorf("backoffWithJitter(attempt=%d) = %v, want between %v and %v", attempt, d, base, base+base/5)
+		}
+	}
+}
+
+func TestCallDownstreamHedgesSlowFirstAttempt(t *testing.T) {
+	collector, err := testutil.NewMockCollector()
+	if err != nil {
+		t.Fatalf("failed to start mock collector: %v", err)
+	}
+	defer collector.Close()
+
+	mp := setupTestTelemetry(t, collector.Addr, "")
+	srv, err := newServer("127.0.0.1:0", "127.0.0.1:0", mp.Meter("service-a-client-test"))
+	if err != nil {
+		t.Fatalf("failed to create server: %v", err)
+	}
+	defer srv.serviceBConn.Close()
+	defer srv.serviceCConn.Close()
+
+	policy := CallPolicy{
+		PerAttemptTimeout: 2 * time.Second,
+		MaxAttempts:       1,
+		HedgeDelay:        20 * time.Millisecond,
+	}
+
+	var calls int32
+	fn := func(ctx context.Context) error {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			// The first call never returns before HedgeDelay elapses, so
+			// runHedgedRequest should race a second one and take its result.
+			time.Sleep(200 * time.Millisecond)
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := srv.callDownstream(ctx, "service-b", "ProcessData", policy, fn); err != nil {
+		t.Fatalf("callDownstream returned error: %v", err)
+	}
+
+	if got := atomic.LoadInt32(&calls); got != 2 {
+		t.Errorf("expected the slow first attempt to be hedged by a second call, got %d call(s)", got)
+	}
+}
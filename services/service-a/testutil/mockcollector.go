@@ -0,0 +1,98 @@
+// Package testutil provides an in-process mock OTLP collector for Service A's
+// integration tests, so tests can assert on what was actually exported
+// without standing up a real collector.
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	collectorlogs "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	collectormetrics "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	collectortrace "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+)
+
+type mockTraceService struct {
+	collectortrace.UnimplementedTraceServiceServer
+	Requests chan *collectortrace.ExportTraceServiceRequest
+}
+
+func (s *mockTraceService) Export(ctx context.Context, req *collectortrace.ExportTraceServiceRequest) (*collectortrace.ExportTraceServiceResponse, error) {
+	s.Requests <- req
+	return &collectortrace.ExportTraceServiceResponse{}, nil
+}
+
+type mockLogsService struct {
+	collectorlogs.UnimplementedLogsServiceServer
+	Requests chan *collectorlogs.ExportLogsServiceRequest
+}
+
+func (s *mockLogsService) Export(ctx context.Context, req *collectorlogs.ExportLogsServiceRequest) (*collectorlogs.ExportLogsServiceResponse, error) {
+	s.Requests <- req
+	return &collectorlogs.ExportLogsServiceResponse{}, nil
+}
+
+type mockMetricsService struct {
+	collectormetrics.UnimplementedMetricsServiceServer
+	Requests chan *collectormetrics.ExportMetricsServiceRequest
+}
+
+func (s *mockMetricsService) Export(ctx context.Context, req *collectormetrics.ExportMetricsServiceRequest) (*collectormetrics.ExportMetricsServiceResponse, error) {
+	s.Requests <- req
+	return &collectormetrics.ExportMetricsServiceResponse{}, nil
+}
+
+// MockCollector is an in-process OTLP collector implementing the
+// TraceService, LogsService, and MetricsService gRPC servers. Every export
+// request it receives is pushed onto the matching buffered channel so tests
+// can assert on exactly what Service A sent.
+type MockCollector struct {
+	Addr string
+
+	TraceRequests  chan *collectortrace.ExportTraceServiceRequest
+	LogRequests    chan *collectorlogs.ExportLogsServiceRequest
+	MetricRequests chan *collectormetrics.ExportMetricsServiceRequest
+
+	server   *grpc.Server
+	listener net.Listener
+}
+
+// NewMockCollector starts a MockCollector listening on an OS-assigned local
+// port and returns it once it's ready to accept connections.
+func NewMockCollector() (*MockCollector, error) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen: %w", err)
+	}
+
+	traceSvc := &mockTraceService{Requests: make(chan *collectortrace.ExportTraceServiceRequest, 64)}
+	logsSvc := &mockLogsService{Requests: make(chan *collectorlogs.ExportLogsServiceRequest, 64)}
+	metricsSvc := &mockMetricsService{Requests: make(chan *collectormetrics.ExportMetricsServiceRequest, 64)}
+
+	server := grpc.NewServer()
+	collectortrace.RegisterTraceServiceServer(server, traceSvc)
+	collectorlogs.RegisterLogsServiceServer(server, logsSvc)
+	collectormetrics.RegisterMetricsServiceServer(server, metricsSvc)
+
+	c := &MockCollector{
+		Addr:           lis.Addr().String(),
+		TraceRequests:  traceSvc.Requests,
+		LogRequests:    logsSvc.Requests,
+		MetricRequests: metricsSvc.Requests,
+		server:         server,
+		listener:       lis,
+	}
+
+	// The listener is already bound, so callers can dial it immediately;
+	// connections queue in the OS backlog until Serve starts accepting.
+	go server.Serve(lis)
+
+	return c, nil
+}
+
+// Close stops the collector and releases its listener.
+func (c *MockCollector) Close() {
+	c.server.Stop()
+}